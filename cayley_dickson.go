@@ -50,10 +50,31 @@ func Abs[A Value[A, F], F Field](x A) F {
 }
 
 // Exp returns e**x, the base-e exponential of x.
+//
+// Exp conforms to the C99 Annex G.6 special cases adopted by math/cmplx:
+// Exp(+Inf) is +Inf, Exp(-Inf+uv·i) is 0 for any finite or non-finite uv,
+// and Exp(+Inf+uv·i) for non-finite uv is +Inf with NaN in the
+// non-real components, since the direction is then undefined.
 func Exp[A Value[A, F], F Field](x A) A {
 	w := x.Real()
 	uv := x.Imag()
 	var zero A
+	if math.IsInf(float64(w), 0) {
+		switch {
+		case w > 0 && uv == zero:
+			return x
+		case IsInf(uv) || IsNaN(uv):
+			if w < 0 {
+				return signedZero[A](uv)
+			}
+			return infNaN[A]()
+		case w > 0:
+			v := Abs(uv)
+			n := uv.Scale(1 / v)
+			s, c := math.Sincos(float64(v))
+			return Lift[A](F(math.Inf(1) * c)).Add(scaleInfDir[A](n, F(math.Inf(1)*s)))
+		}
+	}
 	if uv == zero {
 		return Lift[A](F(math.Exp(float64(w))))
 	}
@@ -63,6 +84,113 @@ func Exp[A Value[A, F], F Field](x A) A {
 	return Lift[A](F(e * c)).Add(uv.Scale(F(e * s / v)))
 }
 
+// IsInf reports whether any element of x is an infinity.
+func IsInf[A Value[A, F], F Field](x A) bool {
+	for _, e := range x.Elems() {
+		if math.IsInf(float64(e), 0) {
+			return true
+		}
+	}
+	return false
+}
+
+// IsNaN reports whether any element of x is NaN and no element is an
+// infinity.
+func IsNaN[A Value[A, F], F Field](x A) bool {
+	if IsInf(x) {
+		return false
+	}
+	for _, e := range x.Elems() {
+		if math.IsNaN(float64(e)) {
+			return true
+		}
+	}
+	return false
+}
+
+// NaN returns a "not-a-number" value for the algebra, with all elements NaN.
+func NaN[A Value[A, F], F Field]() A {
+	var zero A
+	e := zero.Elems()
+	for i := range e {
+		e[i] = F(math.NaN())
+	}
+	return *(*A)(unsafe.Pointer(&e[0]))
+}
+
+// infNaN returns the value with a positive infinite real part and NaN
+// in every other element, used where a result's direction is undefined.
+func infNaN[A Value[A, F], F Field]() A {
+	var zero A
+	e := zero.Elems()
+	e[0] = F(math.Inf(1))
+	for i := 1; i < len(e); i++ {
+		e[i] = F(math.NaN())
+	}
+	return *(*A)(unsafe.Pointer(&e[0]))
+}
+
+// signedZero returns the zero value of the algebra with each element's
+// sign copied from the corresponding element of uv.
+func signedZero[A Value[A, F], F Field](uv A) A {
+	var zero A
+	e := zero.Elems()
+	src := uv.Elems()
+	for i := range e {
+		e[i] = F(math.Copysign(0, float64(src[i])))
+	}
+	return *(*A)(unsafe.Pointer(&e[0]))
+}
+
+// infDir returns a unit-magnitude direction standing in for uv/Abs(uv)
+// when Abs(uv) is itself infinite, so that the division would otherwise
+// produce a 0×Inf NaN in every finite element of uv. Each infinite
+// element of uv keeps its sign and every finite element, including an
+// exactly zero one, is flushed to zero.
+func infDir[A Value[A, F], F Field](uv A) A {
+	var zero A
+	e := zero.Elems()
+	src := uv.Elems()
+	for i := range e {
+		if math.IsInf(float64(src[i]), 0) {
+			e[i] = F(math.Copysign(1, float64(src[i])))
+		}
+	}
+	return *(*A)(unsafe.Pointer(&e[0]))
+}
+
+// signInf returns an infinite value with each non-zero element's sign
+// copied from the corresponding element of uv, and every exactly zero
+// element of uv left zero.
+func signInf[A Value[A, F], F Field](uv A) A {
+	var zero A
+	e := zero.Elems()
+	src := uv.Elems()
+	for i := range e {
+		if src[i] == 0 {
+			continue
+		}
+		e[i] = F(math.Copysign(math.Inf(1), float64(src[i])))
+	}
+	return *(*A)(unsafe.Pointer(&e[0]))
+}
+
+// scaleInfDir scales the unit direction vector n by the, possibly
+// infinite, magnitude mag, treating an exactly zero element of n as
+// remaining zero rather than propagating a 0×Inf NaN.
+func scaleInfDir[A Value[A, F], F Field](n A, mag F) A {
+	var zero A
+	e := zero.Elems()
+	src := n.Elems()
+	for i := range e {
+		if src[i] == 0 {
+			continue
+		}
+		e[i] = F(float64(src[i]) * float64(mag))
+	}
+	return *(*A)(unsafe.Pointer(&e[0]))
+}
+
 // Inf returns an infinity for the algebra, with all elements positive infinity.
 func Inf[A Value[A, F], F Field]() A {
 	var zero A
@@ -88,11 +216,24 @@ func Lift[A Value[A, F], F Field](f F) A {
 }
 
 // Log returns the natural logarithm of x.
+//
+// Log(0) is -Inf, following from math.Log(0); NaN elements of x that are
+// not accompanied by an infinite element propagate to NaN in every
+// non-real component of the result, as for math/cmplx. A negative real
+// x, having no imaginary part to fix a branch, follows math/cmplx.Log
+// in using the algebra's first imaginary unit for log(-w) = log(w) +
+// iπ.
 func Log[A Value[A, F], F Field](x A) A {
 	w := float64(x.Real())
 	uv := x.Imag()
 	var zero A
+	if IsNaN(x) {
+		return NaN[A]()
+	}
 	if uv == zero {
+		if w < 0 {
+			return Lift[A](F(math.Log(-w))).Add(unit1[A]().Scale(F(math.Pi)))
+		}
 		return Lift[A](F(math.Log(w)))
 	}
 	v := float64(Abs(uv))
@@ -118,7 +259,7 @@ func Pow[A Value[A, F], F Field](x, r A) A {
 				return Lift[A](F(math.Inf(1)))
 			}
 			return Inf[A]()
-		case w < 0:
+		case w > 0:
 			return zero
 		}
 	}
@@ -146,11 +287,18 @@ func PowFloat[A Value[A, F], F Field](x A, r F) A {
 }
 
 // Sqrt returns the square root of x.
+//
+// Sqrt(Inf·n̂), for unit imaginary direction n̂, is Inf + Inf·n̂/√2.
 func Sqrt[A Value[A, F], F Field](x A) A {
 	var zero A
 	if x == zero {
 		return zero
 	}
+	uv := x.Imag()
+	if IsInf(uv) {
+		n := infDir[A](uv)
+		return Lift[A](F(math.Inf(1))).Add(scaleInfDir[A](n, F(math.Inf(1)/math.Sqrt2)))
+	}
 	return PowFloat(x, 0.5)
 }
 
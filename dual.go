@@ -0,0 +1,127 @@
+// Copyright ©2023 Dan Kortschak. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cd
+
+import "unsafe"
+
+// Dual is a dual number over the Cayley–Dickson algebra A, used for
+// first-order perturbation analysis and, in the DualH case, for
+// representing rigid-body screw motions. A value x of Dual[A, F]
+// represents x[0] + x[1]·ε, where ε² = 0.
+type Dual[A Value[A, F], F Field] [2]A
+
+// DualC is a dual number over the complex algebra.
+type DualC = Dual[C, float64]
+
+// NewDualC returns a new dual complex number with the given real and
+// dual (ε) parts.
+func NewDualC(real, dual C) DualC {
+	return Dual[C, float64]{real, dual}
+}
+
+// DualH is a dual number over the quaternion algebra, i.e. a dual
+// quaternion.
+type DualH = Dual[H, float64]
+
+// NewDualH returns a new dual quaternion with the given real and dual
+// (ε) parts.
+func NewDualH(real, dual H) DualH {
+	return Dual[H, float64]{real, dual}
+}
+
+// DualO is a dual number over the octonion algebra.
+type DualO = Dual[O, float64]
+
+// NewDualO returns a new dual octonion with the given real and dual
+// (ε) parts.
+func NewDualO(real, dual O) DualO {
+	return Dual[O, float64]{real, dual}
+}
+
+// Raise raises the 3-dimensional point (x, y, z) into dual quaternion
+// form, giving the point p̂ = 1 + ε(xi+yj+zk). This is the
+// representation applied by a unit dual quaternion q via the sandwich
+// product q·p̂·q.ConjCD().Conj() to carry out a rigid-body motion.
+func Raise(x, y, z float64) DualH {
+	return NewDualH(NewH(1, 0, 0, 0), NewH(0, x, y, z))
+}
+
+// Real returns the real part of x, drilling down to the scalar field
+// element as for Construction.
+func (x Dual[A, F]) Real() F {
+	return x[0].Real()
+}
+
+// Imag returns the imaginary part of x; the dual (ε) part is, like the
+// Cayley–Dickson imaginary vector part, entirely non-real.
+func (x Dual[A, F]) Imag() Dual[A, F] {
+	return Dual[A, F]{x[0].Imag(), x[1]}
+}
+
+// Scale returns the result of scaling each part of x by f.
+func (x Dual[A, F]) Scale(f F) Dual[A, F] {
+	return Dual[A, F]{x[0].Scale(f), x[1].Scale(f)}
+}
+
+// Neg returns the negation of x.
+func (x Dual[A, F]) Neg() Dual[A, F] {
+	return Dual[A, F]{x[0].Neg(), x[1].Neg()}
+}
+
+// Conj returns the screw-motion conjugate of x, negating the dual part
+// and leaving the real part untouched. Combined with ConjCD, as
+// q.ConjCD().Conj(), it gives the conjugate used to apply a unit dual
+// quaternion to a point via the sandwich product q·p̂·q.ConjCD().Conj().
+//
+// For the conjugate of the underlying Cayley–Dickson algebra, applied
+// component-wise, see ConjCD.
+func (x Dual[A, F]) Conj() Dual[A, F] {
+	return Dual[A, F]{x[0], x[1].Neg()}
+}
+
+// ConjCD returns the Cayley–Dickson conjugate of x, applied
+// independently to the real and dual parts, leaving the sign of the
+// dual part unchanged.
+func (x Dual[A, F]) ConjCD() Dual[A, F] {
+	return Dual[A, F]{x[0].Conj(), x[1].Conj()}
+}
+
+// Add returns the result of adding x and y element-wise.
+func (x Dual[A, F]) Add(y Dual[A, F]) Dual[A, F] {
+	return Dual[A, F]{x[0].Add(y[0]), x[1].Add(y[1])}
+}
+
+// Mul returns the dual-number product of x and y,
+//
+//	(a + bε)(c + dε) = ac + (ad + bc)ε.
+func (x Dual[A, F]) Mul(y Dual[A, F]) Dual[A, F] {
+	a, b := x[0], x[1]
+	c, d := y[0], y[1]
+	return Dual[A, F]{a.Mul(c), a.Mul(d).Add(b.Mul(c))}
+}
+
+// Elems returns the field elements of x.
+func (x Dual[A, F]) Elems() []F {
+	var zero F
+	return unsafe.Slice((*F)(unsafe.Pointer(&x)), unsafe.Sizeof(x)/unsafe.Sizeof(zero))
+}
+
+// ExpDual returns the dual-number exponential of x = a + bε as
+// Exp(a) + b·Exp(a)ε, rather than going through Abs, which is not well
+// defined for dual numbers since ε² = 0. The dual part b·Exp(a) is the
+// derivative of Exp at a in the direction b when a and b commute; for
+// non-commuting a and b it is not.
+func ExpDual[A Value[A, F], F Field](x Dual[A, F]) Dual[A, F] {
+	a, b := x[0], x[1]
+	ea := Exp(a)
+	return Dual[A, F]{ea, b.Mul(ea)}
+}
+
+// LogDual returns the dual-number natural logarithm of x, the inverse
+// of ExpDual.
+func LogDual[A Value[A, F], F Field](x Dual[A, F]) Dual[A, F] {
+	a, b := x[0], x[1]
+	return Dual[A, F]{Log(a), b.Mul(Inv(a))}
+}
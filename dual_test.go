@@ -0,0 +1,93 @@
+// Copyright ©2023 Dan Kortschak. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cd_test
+
+import (
+	"testing"
+
+	"github.com/kortschak/cd"
+)
+
+// TestDualConformsToValue exercises Dual through Abs, which is
+// constrained on Value; it compiles only because Dual implements Value,
+// and confirms Abs's x·x̄ norm is computed correctly through the dual
+// multiplication and conjugation rules for 1 + εi, a pure translation.
+func TestDualConformsToValue(t *testing.T) {
+	x := cd.NewDualH(cd.NewH(1, 0, 0, 0), cd.NewH(0, 1, 0, 0))
+	if got := cd.Abs(x); got != 1 {
+		t.Errorf("unexpected Abs(1+εi): got:%v want:1", got)
+	}
+}
+
+func TestDualMul(t *testing.T) {
+	a, b := cd.NewH(1, 2, 3, 4), cd.NewH(0, 1, 0, 0)
+	c, d := cd.NewH(2, 0, 1, 0), cd.NewH(0, 0, 1, 1)
+	x := cd.NewDualH(a, b)
+	y := cd.NewDualH(c, d)
+
+	got := x.Mul(y)
+	wantReal := a.Mul(c)
+	wantDual := a.Mul(d).Add(b.Mul(c))
+	if got[0] != wantReal {
+		t.Errorf("unexpected real part for Dual Mul: got:%+v want:%+v", got[0], wantReal)
+	}
+	if got[1] != wantDual {
+		t.Errorf("unexpected dual part for Dual Mul: got:%+v want:%+v", got[1], wantDual)
+	}
+}
+
+func TestDualConj(t *testing.T) {
+	a, b := cd.NewH(1, 2, 3, 4), cd.NewH(0.5, -1, 0, 2)
+	x := cd.NewDualH(a, b)
+
+	conj := x.Conj()
+	if conj[0] != a {
+		t.Errorf("unexpected real part for Conj: got:%+v want:%+v", conj[0], a)
+	}
+	if conj[1] != b.Neg() {
+		t.Errorf("unexpected dual part for Conj: got:%+v want:%+v", conj[1], b.Neg())
+	}
+
+	conjCD := x.ConjCD()
+	if conjCD[0] != a.Conj() {
+		t.Errorf("unexpected real part for ConjCD: got:%+v want:%+v", conjCD[0], a.Conj())
+	}
+	if conjCD[1] != b.Conj() {
+		t.Errorf("unexpected dual part for ConjCD: got:%+v want:%+v", conjCD[1], b.Conj())
+	}
+}
+
+// runExpLogDualRoundTrip checks that LogDual(ExpDual(x)) recovers x,
+// for each case in cases, using closeFloat (defined in nan_test.go) to
+// compare elements.
+func runExpLogDualRoundTrip[A cd.Value[A, float64]](t *testing.T, name string, cases []cd.Dual[A, float64]) {
+	t.Helper()
+	for _, x := range cases {
+		got := cd.LogDual(cd.ExpDual(x))
+		ge, we := got.Elems(), x.Elems()
+		for i := range ge {
+			if !closeFloat(ge[i], we[i], 1e-9) {
+				t.Errorf("unexpected element %d for %s round trip of %+v: got:%v want:%v", i, name, x, ge[i], we[i])
+			}
+		}
+	}
+}
+
+func TestExpLogDualRoundTrip(t *testing.T) {
+	// Cases keep the real part's imaginary magnitude well inside the
+	// principal branch (-π, π], as Log(Exp(a)) == a otherwise requires
+	// unwinding, exactly as for complex log(exp(z)).
+	runExpLogDualRoundTrip(t, "DualC", []cd.DualC{
+		cd.NewDualC(cd.NewC(1, 0.8), cd.NewC(0.5, -0.25)),
+		cd.NewDualC(cd.NewC(0.3, -1.1), cd.NewC(0, 0)),
+	})
+	runExpLogDualRoundTrip(t, "DualH", []cd.DualH{
+		cd.NewDualH(cd.NewH(1, 0.4, 0.3, 0.2), cd.NewH(0.5, -0.25, 0.1, 0)),
+		cd.NewDualH(cd.NewH(2, 0, 0, 1), cd.NewH(0, 1, 0, 0)),
+	})
+	runExpLogDualRoundTrip(t, "DualO", []cd.DualO{
+		cd.NewDualO(cd.NewO(1, 0.2, 0, 0, 0, 0, 0.3, 0), cd.NewO(0.2, 0, 0, 0, 0, 0, 0.1, 0)),
+	})
+}
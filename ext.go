@@ -0,0 +1,200 @@
+// Copyright ©2023 Dan Kortschak. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cd
+
+// Elem is the constraint satisfied by elements of an exact base ring
+// or field — a finite field, an arbitrary-precision rational, or
+// similar — used as the base layer of an Ext tower. It is distinct
+// from Field, and deliberately not unified with it, for two reasons:
+//
+//   - Construction's Exp, Log, Sqrt and the trigonometric functions
+//     fundamentally depend on conversion to float64, which an exact
+//     ring has no meaningful instance of.
+//   - Even setting that aside, Go's generics reject the union: a type
+//     set cannot combine the kind terms ~float32 | ~float64 (needed so
+//     bare float32/float64 satisfy Field with no methods) with an
+//     interface term that has methods (needed so Elem's Add/Mul can
+//     perform modular reduction or similar exact-ring bookkeeping that
+//     the native + and * operators know nothing about). The compiler
+//     rejects "Elem[F] contains methods" if the two are merged.
+//
+// So Ext and Ext3 exist as their own tower built directly on Elem,
+// rather than as an instantiation of Construction with a widened
+// Field.
+type Elem[F any] interface {
+	Add(F) F
+	Mul(F) F
+	Neg() F
+	Inv() F
+	Equal(F) bool
+	// Zero and One return the additive and multiplicative identities
+	// of the receiver's ring. A base ring such as a prime field
+	// ignores the receiver's value; Ext and Ext3 carry the receiver's
+	// non-residue into the result.
+	Zero() F
+	One() F
+
+	comparable
+}
+
+// Ext is an element of a quadratic extension of the ring F obtained by
+// adjoining a root u of u² = NonResidue. Nesting Ext, e.g.
+// Ext[Ext[F]], produces the power-of-two towers Fp4, Fp8, Fp16, ... for
+// free. Reaching a degree that is not a power of two, such as BN254's
+// Fp12 (built as Fp2 → Fp6 → Fp12, compare gnark's
+// std/algebra/emulated/fields_bn254), additionally needs the cubic
+// layer Ext3: Fp6 is Ext3[Ext[F]] and Fp12 is Ext[Ext3[Ext[F]]].
+//
+// For F a Field-constrained real type with NonResidue -1, Ext[F]
+// reduces to the same multiplication rule as Construction[R, F]; the
+// two are kept separate because Construction's analytic functions have
+// no meaning over an exact ring.
+type Ext[F Elem[F]] struct {
+	A, B       F
+	NonResidue F
+}
+
+// NewTower returns the element a + b·u of the quadratic extension of F
+// by u, where u² = nonResidue. NewTower is the entry point for
+// building exact towers: Fp2 is Ext[F] via NewTower, Fp4 is
+// Ext[Ext[F]] via nesting NewTower calls, and so on for Fp8, Fp16, ...
+// Towers whose degree is not a power of two, such as Fp12, need a
+// cubic layer from NewCubicTower somewhere in the nesting.
+func NewTower[F Elem[F]](a, b, nonResidue F) Ext[F] {
+	return Ext[F]{A: a, B: b, NonResidue: nonResidue}
+}
+
+// Zero returns the additive identity of x's extension.
+func (x Ext[F]) Zero() Ext[F] {
+	z := x.A.Zero()
+	return Ext[F]{A: z, B: z, NonResidue: x.NonResidue}
+}
+
+// One returns the multiplicative identity of x's extension.
+func (x Ext[F]) One() Ext[F] {
+	one, zero := x.A.One(), x.A.Zero()
+	return Ext[F]{A: one, B: zero, NonResidue: x.NonResidue}
+}
+
+// Add returns the result of adding x and y.
+func (x Ext[F]) Add(y Ext[F]) Ext[F] {
+	return Ext[F]{A: x.A.Add(y.A), B: x.B.Add(y.B), NonResidue: x.NonResidue}
+}
+
+// Neg returns the negation of x.
+func (x Ext[F]) Neg() Ext[F] {
+	return Ext[F]{A: x.A.Neg(), B: x.B.Neg(), NonResidue: x.NonResidue}
+}
+
+// Conj returns the conjugate of x, negating the coefficient of u.
+func (x Ext[F]) Conj() Ext[F] {
+	return Ext[F]{A: x.A, B: x.B.Neg(), NonResidue: x.NonResidue}
+}
+
+// Mul returns the product of x and y using the quadratic extension
+// rule
+//
+//	(a + bu)(c + du) = (ac + β·bd) + (ad + bc)u,
+//
+// where β is the non-residue (u² = β) carried by x.
+func (x Ext[F]) Mul(y Ext[F]) Ext[F] {
+	a, b, beta := x.A, x.B, x.NonResidue
+	c, d := y.A, y.B
+	return Ext[F]{
+		A:          a.Mul(c).Add(beta.Mul(b.Mul(d))),
+		B:          a.Mul(d).Add(b.Mul(c)),
+		NonResidue: beta,
+	}
+}
+
+// Equal reports whether x and y are the same extension element.
+func (x Ext[F]) Equal(y Ext[F]) bool {
+	return x.A.Equal(y.A) && x.B.Equal(y.B)
+}
+
+// Inv returns the inverse of x, using the norm a² − β·b².
+func (x Ext[F]) Inv() Ext[F] {
+	a, b, beta := x.A, x.B, x.NonResidue
+	norm := a.Mul(a).Add(beta.Mul(b.Mul(b)).Neg())
+	normInv := norm.Inv()
+	return Ext[F]{A: a.Mul(normInv), B: b.Neg().Mul(normInv), NonResidue: beta}
+}
+
+// Ext3 is an element of a cubic extension of the ring F obtained by
+// adjoining a root v of v³ = NonResidue. This is the layer missing
+// from Ext that is needed to reach a tower degree that is not a power
+// of two, such as BN254's Fp6 = Ext3[Fp2] on the way to Fp12 =
+// Ext[Ext3[Fp2]].
+type Ext3[F Elem[F]] struct {
+	A, B, C    F
+	NonResidue F
+}
+
+// NewCubicTower returns the element a + b·v + c·v² of the cubic
+// extension of F by v, where v³ = nonResidue.
+func NewCubicTower[F Elem[F]](a, b, c, nonResidue F) Ext3[F] {
+	return Ext3[F]{A: a, B: b, C: c, NonResidue: nonResidue}
+}
+
+// Zero returns the additive identity of x's extension.
+func (x Ext3[F]) Zero() Ext3[F] {
+	z := x.A.Zero()
+	return Ext3[F]{A: z, B: z, C: z, NonResidue: x.NonResidue}
+}
+
+// One returns the multiplicative identity of x's extension.
+func (x Ext3[F]) One() Ext3[F] {
+	one, zero := x.A.One(), x.A.Zero()
+	return Ext3[F]{A: one, B: zero, C: zero, NonResidue: x.NonResidue}
+}
+
+// Add returns the result of adding x and y.
+func (x Ext3[F]) Add(y Ext3[F]) Ext3[F] {
+	return Ext3[F]{A: x.A.Add(y.A), B: x.B.Add(y.B), C: x.C.Add(y.C), NonResidue: x.NonResidue}
+}
+
+// Neg returns the negation of x.
+func (x Ext3[F]) Neg() Ext3[F] {
+	return Ext3[F]{A: x.A.Neg(), B: x.B.Neg(), C: x.C.Neg(), NonResidue: x.NonResidue}
+}
+
+// Mul returns the product of x and y using the cubic extension rule
+//
+//	(a0+a1v+a2v²)(b0+b1v+b2v²) = (a0b0 + β(a1b2+a2b1))
+//	                            + (a0b1+a1b0 + β·a2b2)v
+//	                            + (a0b2+a1b1+a2b0)v²,
+//
+// where β is the non-residue (v³ = β) carried by x.
+func (x Ext3[F]) Mul(y Ext3[F]) Ext3[F] {
+	a0, a1, a2, beta := x.A, x.B, x.C, x.NonResidue
+	b0, b1, b2 := y.A, y.B, y.C
+	return Ext3[F]{
+		A:          a0.Mul(b0).Add(beta.Mul(a1.Mul(b2).Add(a2.Mul(b1)))),
+		B:          a0.Mul(b1).Add(a1.Mul(b0)).Add(beta.Mul(a2.Mul(b2))),
+		C:          a0.Mul(b2).Add(a1.Mul(b1)).Add(a2.Mul(b0)),
+		NonResidue: beta,
+	}
+}
+
+// Equal reports whether x and y are the same extension element.
+func (x Ext3[F]) Equal(y Ext3[F]) bool {
+	return x.A.Equal(y.A) && x.B.Equal(y.B) && x.C.Equal(y.C)
+}
+
+// Inv returns the inverse of x, computed via the standard cubic
+// extension inversion: x·(c0+c1v+c2v²) reduces to the scalar norm
+// a0³ + β·a1³ + β²·a2³ − 3β·a0a1a2, which is then inverted and
+// distributed back over c0, c1 and c2.
+func (x Ext3[F]) Inv() Ext3[F] {
+	a0, a1, a2, beta := x.A, x.B, x.C, x.NonResidue
+	t0, t1, t2 := a0.Mul(a0), a1.Mul(a1), a2.Mul(a2)
+	t3, t4, t5 := a0.Mul(a1), a0.Mul(a2), a1.Mul(a2)
+	c0 := t0.Add(beta.Mul(t5).Neg())
+	c1 := beta.Mul(t2).Add(t3.Neg())
+	c2 := t1.Add(t4.Neg())
+	norm := a0.Mul(c0).Add(beta.Mul(a1.Mul(c2).Add(a2.Mul(c1))))
+	normInv := norm.Inv()
+	return Ext3[F]{A: c0.Mul(normInv), B: c1.Mul(normInv), C: c2.Mul(normInv), NonResidue: beta}
+}
@@ -0,0 +1,105 @@
+// Copyright ©2023 Dan Kortschak. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cd_test
+
+import (
+	"fmt"
+
+	"github.com/kortschak/cd"
+)
+
+// ratField is a toy Elem implementation over float64, standing in for
+// an arbitrary-precision or finite-field type such as a wrapped
+// *big.Rat or a prime-field element, to exercise Ext independently of
+// any particular exact ring implementation.
+type ratField float64
+
+func (x ratField) Add(y ratField) ratField { return x + y }
+func (x ratField) Mul(y ratField) ratField { return x * y }
+func (x ratField) Neg() ratField           { return -x }
+func (x ratField) Inv() ratField           { return 1 / x }
+func (x ratField) Equal(y ratField) bool   { return x == y }
+func (x ratField) Zero() ratField          { return 0 }
+func (x ratField) One() ratField           { return 1 }
+
+// Building Fp2 with non-residue -1 reduces to ordinary complex
+// multiplication.
+func Example_ext() {
+	a := cd.NewTower[ratField](3, 4, -1)
+	b := cd.NewTower[ratField](1, 2, -1)
+
+	fmt.Println(a.Mul(b))
+	fmt.Println(a.Mul(a.Inv()))
+
+	// Output:
+	// {-5 10 -1}
+	// {1 0 -1}
+}
+
+const modulus = 11
+
+// fp11 is a toy prime-field Elem implementation, standing in for a
+// wrapped *big.Rat or a genuine finite-field element, used to build a
+// degree-12 tower exactly, without the float64 rounding that a ratField
+// base would accumulate across three nested layers.
+type fp11 int64
+
+func (x fp11) norm() fp11 {
+	r := int64(x) % modulus
+	if r < 0 {
+		r += modulus
+	}
+	return fp11(r)
+}
+
+func (x fp11) Add(y fp11) fp11   { return (x + y).norm() }
+func (x fp11) Mul(y fp11) fp11   { return (x * y).norm() }
+func (x fp11) Neg() fp11         { return (-x).norm() }
+func (x fp11) Equal(y fp11) bool { return x.norm() == y.norm() }
+func (x fp11) Zero() fp11        { return 0 }
+func (x fp11) One() fp11         { return 1 }
+
+// Inv returns the multiplicative inverse of x via Fermat's little
+// theorem, x**(modulus-2).
+func (x fp11) Inv() fp11 {
+	r := fp11(1)
+	b := x.norm()
+	for e := modulus - 2; e > 0; e >>= 1 {
+		if e&1 == 1 {
+			r = r.Mul(b)
+		}
+		b = b.Mul(b)
+	}
+	return r
+}
+
+// Example_ext3 builds a BN254-style Fp12 tower, Fp2 → Fp6 → Fp12, as
+// Ext[Ext3[Ext[fp11]]], and checks that a·a⁻¹ is the tower's identity.
+func Example_ext3() {
+	fp2NonResidue := fp11(-1)
+	fp6NonResidue := cd.NewTower[fp11](2, 0, fp2NonResidue)
+	fp12NonResidue := cd.NewCubicTower(fp6NonResidue.Zero(), fp6NonResidue.One(), fp6NonResidue.Zero(), fp6NonResidue)
+
+	a := cd.NewTower(
+		cd.NewCubicTower(
+			cd.NewTower[fp11](1, 1, fp2NonResidue),
+			cd.NewTower[fp11](0, 0, fp2NonResidue),
+			cd.NewTower[fp11](0, 0, fp2NonResidue),
+			fp6NonResidue,
+		),
+		cd.NewCubicTower(
+			cd.NewTower[fp11](2, 0, fp2NonResidue),
+			cd.NewTower[fp11](0, 1, fp2NonResidue),
+			cd.NewTower[fp11](0, 0, fp2NonResidue),
+			fp6NonResidue,
+		),
+		fp12NonResidue,
+	)
+
+	fmt.Println(a.Mul(a.Inv()))
+
+	// Output:
+	// {{{1 0 -1} {0 0 -1} {0 0 -1} {2 0 -1}} {{0 0 -1} {0 0 -1} {0 0 -1} {2 0 -1}} {{0 0 -1} {1 0 -1} {0 0 -1} {2 0 -1}}}
+}
@@ -0,0 +1,108 @@
+// Copyright ©2023 Dan Kortschak. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cd
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// basis holds the conventional Cayley–Dickson basis labels for the
+// real part ("", printed bare) and the first 15 imaginary units, enough
+// to label every element of S, the largest tower built into the
+// package. Elements beyond that fall back to a numbered "eN" label.
+var basis = [...]string{"", "i", "j", "k", "e", "f", "g", "h", "l", "m", "n", "o", "p", "q", "r", "s"}
+
+// basisLabel returns the basis label for element i of a tower.
+func basisLabel(i int) string {
+	if i < len(basis) {
+		return basis[i]
+	}
+	return "e" + strconv.Itoa(i)
+}
+
+// Format implements fmt.Formatter, printing x as a sum of its elements
+// against their Cayley–Dickson basis labels, e.g. "(1+2i+3j+4k)" for an
+// H value. The %v, %e, %f and %g verbs, and their upper-case forms, are
+// supported; width and precision flags are applied to each element.
+// %#v prints the GoString form instead.
+func (x Construction[A, F]) Format(fs fmt.State, verb rune) {
+	switch verb {
+	case 'v':
+		if fs.Flag('#') {
+			io.WriteString(fs, x.GoString())
+			return
+		}
+	case 'e', 'E', 'f', 'F', 'g', 'G':
+	default:
+		fmt.Fprintf(fs, "%%!%c(%T)", verb, x)
+		return
+	}
+
+	elems := x.Elems()
+	var buf strings.Builder
+	buf.WriteByte('(')
+	for i, e := range elems {
+		s := fmt.Sprintf(elemFormat(fs, verb, i != 0), e)
+		if i != 0 {
+			buf.WriteString(s)
+			buf.WriteString(basisLabel(i))
+		} else {
+			buf.WriteString(s)
+		}
+	}
+	buf.WriteByte(')')
+	io.WriteString(fs, buf.String())
+}
+
+// elemFormat builds the printf verb used to format a single element of
+// x, translating the bare 'v' verb to 'g' and, for non-leading
+// elements, forcing the '+' flag so that the element is always
+// unambiguously signed.
+func elemFormat(fs fmt.State, verb rune, forceSign bool) string {
+	if verb == 'v' {
+		verb = 'g'
+	}
+	var b strings.Builder
+	b.WriteByte('%')
+	for _, f := range "-+ 0" {
+		if (forceSign && f == '+') || fs.Flag(int(f)) {
+			b.WriteRune(f)
+		}
+	}
+	if w, ok := fs.Width(); ok {
+		fmt.Fprintf(&b, "%d", w)
+	}
+	if p, ok := fs.Precision(); ok {
+		b.WriteByte('.')
+		fmt.Fprintf(&b, "%d", p)
+	}
+	b.WriteRune(verb)
+	return b.String()
+}
+
+// constructor names the package-level New function for each tower
+// depth that the package itself defines an alias for.
+var constructor = map[int]string{2: "NewC", 4: "NewH", 8: "NewO", 16: "NewS"}
+
+// GoString implements fmt.GoStringer, returning a round-trippable
+// literal such as "cd.NewH(1, 2, 3, 4)". A tower with no package-level
+// constructor is printed in a descriptive form that is not valid Go.
+func (x Construction[A, F]) GoString() string {
+	elems := x.Elems()
+	args := make([]string, len(elems))
+	for i, e := range elems {
+		args[i] = fmt.Sprintf("%v", e)
+	}
+	name, ok := constructor[len(elems)]
+	if !ok {
+		// No package-level constructor exists for this tower depth;
+		// fall back to a descriptive listing of its elements.
+		return fmt.Sprintf("cd.Construction[.../* %d elems */](%s)", len(elems), strings.Join(args, ", "))
+	}
+	return "cd." + name + "(" + strings.Join(args, ", ") + ")"
+}
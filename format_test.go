@@ -0,0 +1,65 @@
+// Copyright ©2023 Dan Kortschak. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cd_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/kortschak/cd"
+)
+
+// TestFormatH checks the %v, %+v, %#v, %e and %.2f verbs against H.
+func TestFormatH(t *testing.T) {
+	x := cd.NewH(1, -2, 3, 0)
+	cases := []struct {
+		format string
+		want   string
+	}{
+		{"%v", "(1-2i+3j+0k)"},
+		{"%+v", "(+1-2i+3j+0k)"},
+		{"%#v", "cd.NewH(1, -2, 3, 0)"},
+		{"%e", "(1.000000e+00-2.000000e+00i+3.000000e+00j+0.000000e+00k)"},
+		{"%.2f", "(1.00-2.00i+3.00j+0.00k)"},
+	}
+	for _, c := range cases {
+		got := fmt.Sprintf(c.format, x)
+		if got != c.want {
+			t.Errorf("unexpected result for Sprintf(%q, %v): got:%s want:%s", c.format, x, got, c.want)
+		}
+	}
+}
+
+// TestFormatO checks the %v, %+v, %#v, %e and %.2f verbs against O.
+func TestFormatO(t *testing.T) {
+	x := cd.NewO(1, -2, 3, 0, 0.5, 0, 0, -1)
+	cases := []struct {
+		format string
+		want   string
+	}{
+		{"%v", "(1-2i+3j+0k+0.5e+0f+0g-1h)"},
+		{"%+v", "(+1-2i+3j+0k+0.5e+0f+0g-1h)"},
+		{"%#v", "cd.NewO(1, -2, 3, 0, 0.5, 0, 0, -1)"},
+		{"%e", "(1.000000e+00-2.000000e+00i+3.000000e+00j+0.000000e+00k+5.000000e-01e+0.000000e+00f+0.000000e+00g-1.000000e+00h)"},
+		{"%.2f", "(1.00-2.00i+3.00j+0.00k+0.50e+0.00f+0.00g-1.00h)"},
+	}
+	for _, c := range cases {
+		got := fmt.Sprintf(c.format, x)
+		if got != c.want {
+			t.Errorf("unexpected result for Sprintf(%q, %v): got:%s want:%s", c.format, x, got, c.want)
+		}
+	}
+}
+
+// TestFormatBadVerb checks that an unsupported verb is reported in
+// fmt's %!verb(type) style, without the value, rather than panicking
+// or silently falling back to a default representation.
+func TestFormatBadVerb(t *testing.T) {
+	x := cd.NewH(1, 2, 3, 4)
+	want := fmt.Sprintf("%%!d(%T)", x)
+	if got := fmt.Sprintf("%d", x); got != want {
+		t.Errorf("unexpected result for bad verb: got:%s want:%s", got, want)
+	}
+}
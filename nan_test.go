@@ -0,0 +1,211 @@
+// Copyright ©2023 Dan Kortschak. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cd_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/kortschak/cd"
+)
+
+var (
+	inf  = math.Inf(1)
+	ninf = math.Inf(-1)
+	nan  = math.NaN()
+)
+
+// closeFloat reports whether got and want agree to within tol,
+// treating a NaN or infinite want as requiring an exact match in
+// kind from got.
+func closeFloat(got, want, tol float64) bool {
+	switch {
+	case math.IsNaN(want):
+		return math.IsNaN(got)
+	case math.IsInf(want, 1):
+		return math.IsInf(got, 1)
+	case math.IsInf(want, -1):
+		return math.IsInf(got, -1)
+	}
+	return math.Abs(got-want) <= tol*(1+math.Abs(want))
+}
+
+// boolCase is a table-driven case for a predicate such as IsNaN or
+// IsInf over the algebra A.
+type boolCase[A cd.Value[A, float64]] struct {
+	x    A
+	want bool
+}
+
+func runIsNaN[A cd.Value[A, float64]](t *testing.T, cases []boolCase[A]) {
+	t.Helper()
+	for _, c := range cases {
+		got := cd.IsNaN(c.x)
+		if got != c.want {
+			t.Errorf("unexpected result for IsNaN(%+v): got:%t want:%t", c.x, got, c.want)
+		}
+	}
+}
+
+func TestIsNaN(t *testing.T) {
+	runIsNaN(t, []boolCase[cd.C]{
+		{cd.NewC(1, 2), false},
+		{cd.NewC(nan, 0), true},
+		{cd.NewC(0, nan), true},
+		{cd.NewC(inf, 0), false},
+		{cd.NewC(inf, nan), false},
+		{cd.NewC(ninf, nan), false},
+	})
+	runIsNaN(t, []boolCase[cd.H]{
+		{cd.NewH(1, 2, 3, 4), false},
+		{cd.NewH(nan, 0, 0, 0), true},
+		{cd.NewH(0, nan, 0, 0), true},
+		{cd.NewH(inf, 0, 0, 0), false},
+		{cd.NewH(inf, nan, 0, 0), false},
+		{cd.NewH(ninf, nan, 0, 0), false},
+	})
+	runIsNaN(t, []boolCase[cd.O]{
+		{cd.NewO(1, 2, 3, 4, 5, 6, 7, 8), false},
+		{cd.NewO(nan, 0, 0, 0, 0, 0, 0, 0), true},
+		{cd.NewO(0, 0, 0, 0, 0, 0, nan, 0), true},
+		{cd.NewO(inf, 0, 0, 0, 0, 0, 0, 0), false},
+		{cd.NewO(inf, 0, 0, 0, 0, 0, nan, 0), false},
+		{cd.NewO(ninf, 0, 0, 0, 0, 0, nan, 0), false},
+	})
+}
+
+func runIsInf[A cd.Value[A, float64]](t *testing.T, cases []boolCase[A]) {
+	t.Helper()
+	for _, c := range cases {
+		got := cd.IsInf(c.x)
+		if got != c.want {
+			t.Errorf("unexpected result for IsInf(%+v): got:%t want:%t", c.x, got, c.want)
+		}
+	}
+}
+
+func TestIsInf(t *testing.T) {
+	runIsInf(t, []boolCase[cd.C]{
+		{cd.NewC(1, 2), false},
+		{cd.NewC(inf, 0), true},
+		{cd.NewC(0, ninf), true},
+		{cd.NewC(nan, 0), false},
+	})
+	runIsInf(t, []boolCase[cd.H]{
+		{cd.NewH(1, 2, 3, 4), false},
+		{cd.NewH(inf, 0, 0, 0), true},
+		{cd.NewH(0, 0, ninf, 0), true},
+		{cd.NewH(nan, 0, 0, 0), false},
+	})
+	runIsInf(t, []boolCase[cd.O]{
+		{cd.NewO(1, 2, 3, 4, 5, 6, 7, 8), false},
+		{cd.NewO(inf, 0, 0, 0, 0, 0, 0, 0), true},
+		{cd.NewO(0, 0, 0, 0, 0, 0, ninf, 0), true},
+		{cd.NewO(nan, 0, 0, 0, 0, 0, 0, 0), false},
+	})
+}
+
+// elemsCase is a table-driven case checking the leading two elements
+// (the real part and the first imaginary slot) of a function's result,
+// the slots that every C, H and O value shares.
+type elemsCase[A cd.Value[A, float64]] struct {
+	x        A
+	wantReal float64
+	wantImag float64
+}
+
+func runElems[A cd.Value[A, float64]](t *testing.T, name string, f func(A) A, cases []elemsCase[A]) {
+	t.Helper()
+	for _, c := range cases {
+		got := f(c.x).Elems()
+		if !closeFloat(got[0], c.wantReal, 1e-9) {
+			t.Errorf("unexpected real part for %s(%+v): got:%v want:%v", name, c.x, got[0], c.wantReal)
+		}
+		if !closeFloat(got[1], c.wantImag, 1e-9) {
+			t.Errorf("unexpected imaginary part for %s(%+v): got:%v want:%v", name, c.x, got[1], c.wantImag)
+		}
+	}
+}
+
+func TestLog(t *testing.T) {
+	runElems(t, "Log", cd.Log[cd.C], []elemsCase[cd.C]{
+		{cd.NewC(0, 0), ninf, 0},
+		{cd.NewC(-2, 0), math.Log(2), math.Pi},
+		{cd.NewC(1, 0), 0, 0},
+	})
+	runElems(t, "Log", cd.Log[cd.H], []elemsCase[cd.H]{
+		{cd.NewH(0, 0, 0, 0), ninf, 0},
+		{cd.NewH(-2, 0, 0, 0), math.Log(2), math.Pi},
+	})
+	runElems(t, "Log", cd.Log[cd.O], []elemsCase[cd.O]{
+		{cd.NewO(0, 0, 0, 0, 0, 0, 0, 0), ninf, 0},
+		{cd.NewO(-2, 0, 0, 0, 0, 0, 0, 0), math.Log(2), math.Pi},
+	})
+}
+
+func TestSqrtInf(t *testing.T) {
+	runElems(t, "Sqrt", cd.Sqrt[cd.C], []elemsCase[cd.C]{
+		{cd.NewC(inf, 1), inf, 0},
+		{cd.NewC(0, inf), inf, inf},
+	})
+	runElems(t, "Sqrt", cd.Sqrt[cd.H], []elemsCase[cd.H]{
+		{cd.NewH(inf, 1, 0, 0), inf, 0},
+		{cd.NewH(0, inf, 0, 0), inf, inf},
+	})
+	runElems(t, "Sqrt", cd.Sqrt[cd.O], []elemsCase[cd.O]{
+		{cd.NewO(inf, 1, 0, 0, 0, 0, 0, 0), inf, 0},
+		{cd.NewO(0, inf, 0, 0, 0, 0, 0, 0), inf, inf},
+	})
+}
+
+func TestExpInf(t *testing.T) {
+	runElems(t, "Exp", cd.Exp[cd.C], []elemsCase[cd.C]{
+		{cd.NewC(ninf, 1), 0, 0},
+		{cd.NewC(inf, 0), inf, 0},
+	})
+	runElems(t, "Exp", cd.Exp[cd.H], []elemsCase[cd.H]{
+		{cd.NewH(ninf, 1, 0, 0), 0, 0},
+		{cd.NewH(inf, 0, 0, 0), inf, 0},
+	})
+	runElems(t, "Exp", cd.Exp[cd.O], []elemsCase[cd.O]{
+		{cd.NewO(ninf, 1, 0, 0, 0, 0, 0, 0), 0, 0},
+		{cd.NewO(inf, 0, 0, 0, 0, 0, 0, 0), inf, 0},
+	})
+}
+
+// powCase is a table-driven case for Pow(0, r).
+type powCase[A cd.Value[A, float64]] struct {
+	r    A
+	want float64
+}
+
+func runPowZero[A cd.Value[A, float64]](t *testing.T, cases []powCase[A]) {
+	t.Helper()
+	var zero A
+	for _, c := range cases {
+		got := cd.Pow(zero, c.r).Elems()[0]
+		if !closeFloat(got, c.want, 1e-9) {
+			t.Errorf("unexpected result for Pow(0, %+v): got:%v want:%v", c.r, got, c.want)
+		}
+	}
+}
+
+func TestPowZero(t *testing.T) {
+	runPowZero(t, []powCase[cd.C]{
+		{cd.NewC(0, 0), 1},
+		{cd.NewC(2, 0), 0},
+		{cd.NewC(-2, 0), inf},
+	})
+	runPowZero(t, []powCase[cd.H]{
+		{cd.NewH(0, 0, 0, 0), 1},
+		{cd.NewH(2, 0, 0, 0), 0},
+		{cd.NewH(-2, 0, 0, 0), inf},
+	})
+	runPowZero(t, []powCase[cd.O]{
+		{cd.NewO(0, 0, 0, 0, 0, 0, 0, 0), 1},
+		{cd.NewO(2, 0, 0, 0, 0, 0, 0, 0), 0},
+		{cd.NewO(-2, 0, 0, 0, 0, 0, 0, 0), inf},
+	})
+}
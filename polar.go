@@ -0,0 +1,67 @@
+// Copyright ©2023 Dan Kortschak. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cd
+
+import "math"
+
+// Polar returns the modulus of x and its argument, a pure-imaginary
+// unit vector scaled by the angle between x and the real axis. For a
+// unit quaternion built by the cos(φ/2)+n̂·sin(φ/2) convention used to
+// represent a rotation by angle φ about axis n̂, the returned argument
+// is n̂ scaled by the half-angle φ/2. A negative real x, having no
+// imaginary direction of its own, takes the algebra's first imaginary
+// unit, as Log does.
+func Polar[A Value[A, F], F Field](x A) (mod F, arg A) {
+	mod = Abs(x)
+	uv := x.Imag()
+	var zero A
+	if uv == zero {
+		if x.Real() < 0 {
+			return mod, unit1[A]().Scale(F(math.Pi))
+		}
+		return mod, zero
+	}
+	v := Abs(uv)
+	theta := math.Atan2(float64(v), float64(x.Real()))
+	n := uv.Scale(1 / v)
+	return mod, n.Scale(F(theta))
+}
+
+// Rect returns the value with modulus mod and argument arg, the
+// inverse of Polar.
+func Rect[A Value[A, F], F Field](mod F, arg A) A {
+	var zero A
+	if arg == zero {
+		return Lift[A](mod)
+	}
+	theta := Abs(arg)
+	n := arg.Scale(1 / theta)
+	s, c := math.Sincos(float64(theta))
+	return Lift[A](F(float64(mod) * c)).Add(n.Scale(F(float64(mod) * s)))
+}
+
+// Slerp returns the spherical linear interpolation between the unit
+// quaternions a and b at t in [0, 1], following the shortest path
+// between them. If a and b are nearly parallel, Slerp falls back to
+// Nlerp to avoid the numerical instability of Exp/Log close to the
+// identity rotation.
+func Slerp(a, b H, t float64) H {
+	if a.Mul(b.Conj()).Real() < 0 {
+		b = b.Neg()
+	}
+	const parallelTol = 1e-6
+	if 1-math.Abs(a.Mul(b.Conj()).Real()) < parallelTol {
+		return Nlerp(a, b, t)
+	}
+	return a.Mul(Exp(Log(Inv(a).Mul(b)).Scale(t)))
+}
+
+// Nlerp returns the normalized linear interpolation between the unit
+// quaternions a and b at t in [0, 1]. It is cheaper than Slerp but
+// does not move at constant angular velocity.
+func Nlerp(a, b H, t float64) H {
+	r := a.Scale(1 - t).Add(b.Scale(t))
+	return r.Scale(1 / Abs(r))
+}
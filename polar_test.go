@@ -0,0 +1,38 @@
+// Copyright ©2023 Dan Kortschak. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cd_test
+
+import (
+	"fmt"
+	"math"
+
+	"gonum.org/v1/gonum/floats/scalar"
+
+	"github.com/kortschak/cd"
+)
+
+// Spherically interpolate a quarter turn about the x axis in four steps.
+func Example_slerp() {
+	alpha := math.Pi / 2
+	axis := cd.NewH(0, 1, 0, 0)
+	a := cd.NewH(1, 0, 0, 0)
+	b := axis.Scale(math.Sin(alpha / 2)).Add(cd.Lift[cd.H](math.Cos(alpha / 2)))
+
+	for _, t := range []float64{0, 0.25, 0.5, 0.75, 1} {
+		s := cd.Slerp(a, b, t)
+		e := s.Elems()
+		for i := range e {
+			e[i] = scalar.Round(e[i], 4)
+		}
+		fmt.Printf("%.2f %+v\n", t, e)
+	}
+
+	// Output:
+	// 0.00 [1 0 0 0]
+	// 0.25 [0.9808 0.1951 0 0]
+	// 0.50 [0.9239 0.3827 0 0]
+	// 0.75 [0.8315 0.5556 0 0]
+	// 1.00 [0.7071 0.7071 0 0]
+}
@@ -0,0 +1,271 @@
+// Copyright ©2023 Dan Kortschak. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cd
+
+import (
+	"math"
+	"unsafe"
+)
+
+// split decomposes x into its real part w, the modulus v of its
+// imaginary vector part, and the unit imaginary direction n that
+// stands in for the complex i in the trigonometric identities below.
+// isReal is true when x has a zero imaginary vector part, in which
+// case v and n are zero valued and unused.
+func split[A Value[A, F], F Field](x A) (w, v F, n A, isReal bool) {
+	w = x.Real()
+	uv := x.Imag()
+	var zero A
+	if uv == zero {
+		return w, 0, zero, true
+	}
+	v = Abs(uv)
+	if math.IsInf(float64(v), 0) {
+		return w, v, infDir[A](uv), false
+	}
+	return w, v, uv.Scale(1 / v), false
+}
+
+// Sin returns the sine of x.
+//
+// Sin conforms to the C99 Annex G.6 special cases adopted by
+// math/cmplx: Sin(NaN) is NaN, Sin(w+Inf·n̂) for finite nonzero w is
+// infinite in the direction n̂, and Sin(Inf·n̂) for pure-imaginary
+// infinite x is x unchanged.
+func Sin[A Value[A, F], F Field](x A) A {
+	w := x.Real()
+	uv := x.Imag()
+	var zero A
+	switch {
+	case uv == zero && (math.IsInf(float64(w), 0) || math.IsNaN(float64(w))):
+		return Lift[A](F(math.NaN()))
+	case w == 0 && IsNaN(uv):
+		return x
+	case IsInf(uv):
+		switch {
+		case w == 0:
+			return x
+		case math.IsInf(float64(w), 0) || math.IsNaN(float64(w)):
+			return Lift[A](F(math.NaN())).Add(uv)
+		}
+	}
+	w, v, n, isReal := split[A](x)
+	if isReal {
+		return Lift[A](F(math.Sin(float64(w))))
+	}
+	s, c := math.Sin(float64(w)), math.Cos(float64(w))
+	sh, ch := math.Sinh(float64(v)), math.Cosh(float64(v))
+	return Lift[A](F(s * ch)).Add(scaleInfDir[A](n, F(c*sh)))
+}
+
+// Cos returns the cosine of x.
+//
+// Cos conforms to the C99 Annex G.6 special cases adopted by
+// math/cmplx: Cos(NaN) is NaN, Cos(w+Inf·n̂) for finite nonzero w is
+// infinite in the direction n̂, and Cos(Inf·n̂) for pure-imaginary
+// infinite x is +Inf.
+func Cos[A Value[A, F], F Field](x A) A {
+	w := x.Real()
+	uv := x.Imag()
+	var zero A
+	switch {
+	case uv == zero && (math.IsInf(float64(w), 0) || math.IsNaN(float64(w))):
+		return Lift[A](F(math.NaN()))
+	case w == 0 && IsNaN(uv):
+		return Lift[A](F(math.NaN()))
+	case IsInf(uv):
+		switch {
+		case w == 0:
+			return Lift[A](F(math.Inf(1)))
+		case math.IsInf(float64(w), 0) || math.IsNaN(float64(w)):
+			return Lift[A](F(math.Inf(1))).Add(NaN[A]().Imag())
+		}
+	}
+	w, v, n, isReal := split[A](x)
+	if isReal {
+		return Lift[A](F(math.Cos(float64(w))))
+	}
+	s, c := math.Sin(float64(w)), math.Cos(float64(w))
+	sh, ch := math.Sinh(float64(v)), math.Cosh(float64(v))
+	return Lift[A](F(c * ch)).Add(scaleInfDir[A](n, F(-s*sh)))
+}
+
+// Tan returns the tangent of x.
+func Tan[A Value[A, F], F Field](x A) A {
+	return Sin(x).Mul(Inv(Cos(x)))
+}
+
+// Sinh returns the hyperbolic sine of x.
+//
+// Sinh conforms to the C99 Annex G.6 special cases adopted by
+// math/cmplx: Sinh(NaN) is NaN, Sinh(Inf+v·n̂) for finite nonzero v is
+// infinite in the direction n̂, and Sinh(±Inf) for pure-real infinite
+// x is x unchanged.
+func Sinh[A Value[A, F], F Field](x A) A {
+	w := x.Real()
+	uv := x.Imag()
+	var zero A
+	switch {
+	case w == 0 && (IsInf(uv) || IsNaN(uv)):
+		return Lift[A](F(w)).Add(NaN[A]().Imag())
+	case uv == zero && math.IsNaN(float64(w)):
+		return Lift[A](F(math.NaN()))
+	case math.IsInf(float64(w), 0):
+		switch {
+		case uv == zero:
+			return x
+		case IsInf(uv) || IsNaN(uv):
+			return Lift[A](F(w)).Add(NaN[A]().Imag())
+		}
+	}
+	w, v, n, isReal := split[A](x)
+	if isReal {
+		return Lift[A](F(math.Sinh(float64(w))))
+	}
+	sh, ch := math.Sinh(float64(w)), math.Cosh(float64(w))
+	s, c := math.Sin(float64(v)), math.Cos(float64(v))
+	return Lift[A](F(sh * c)).Add(scaleInfDir[A](n, F(ch*s)))
+}
+
+// Cosh returns the hyperbolic cosine of x.
+//
+// Cosh conforms to the C99 Annex G.6 special cases adopted by
+// math/cmplx: Cosh(NaN) is NaN, Cosh(Inf+v·n̂) for finite nonzero v is
+// infinite in the direction n̂, and Cosh(±Inf) for pure-real infinite
+// x is +Inf.
+func Cosh[A Value[A, F], F Field](x A) A {
+	w := x.Real()
+	uv := x.Imag()
+	var zero A
+	switch {
+	case w == 0 && (IsInf(uv) || IsNaN(uv)):
+		return Lift[A](F(math.NaN()))
+	case uv == zero && math.IsNaN(float64(w)):
+		return Lift[A](F(math.NaN()))
+	case math.IsInf(float64(w), 0):
+		switch {
+		case uv == zero:
+			return Lift[A](F(math.Inf(1)))
+		case IsInf(uv) || IsNaN(uv):
+			return Lift[A](F(math.Inf(1))).Add(NaN[A]().Imag())
+		}
+	}
+	w, v, n, isReal := split[A](x)
+	if isReal {
+		return Lift[A](F(math.Cosh(float64(w))))
+	}
+	sh, ch := math.Sinh(float64(w)), math.Cosh(float64(w))
+	s, c := math.Sin(float64(v)), math.Cos(float64(v))
+	return Lift[A](F(ch * c)).Add(scaleInfDir[A](n, F(sh*s)))
+}
+
+// Tanh returns the hyperbolic tangent of x.
+func Tanh[A Value[A, F], F Field](x A) A {
+	return Sinh(x).Mul(Inv(Cosh(x)))
+}
+
+// unit1 returns the algebra's canonical imaginary unit, the value with
+// 1 in its first imaginary slot and 0 elsewhere. Asin falls back to it
+// as the direction n̂ when x is real but outside [-1, 1], so that the
+// result can be carried in the imaginary part rather than collapsing
+// to NaN. It returns the zero value for R, which has no imaginary slot
+// to carry such a result.
+func unit1[A Value[A, F], F Field]() A {
+	var zero A
+	e := zero.Elems()
+	if len(e) < 2 {
+		return zero
+	}
+	e[1] = 1
+	return *(*A)(unsafe.Pointer(&e[0]))
+}
+
+// Asin returns the inverse sine of x.
+//
+// Asin conforms to the C99 Annex G.6 special cases adopted by
+// math/cmplx for NaN and infinite elements. For real x outside
+// [-1, 1], where math.Asin would give NaN, Asin instead returns the
+// closed-form complex-valued result π/2·sign(x) + i·acosh(|x|), in the
+// direction of the algebra's first imaginary unit, matching
+// math/cmplx.Asin.
+func Asin[A Value[A, F], F Field](x A) A {
+	w := x.Real()
+	uv := x.Imag()
+	switch {
+	case IsNaN(uv):
+		switch {
+		case w == 0:
+			return Lift[A](w).Add(NaN[A]().Imag())
+		case math.IsInf(float64(w), 0):
+			return Lift[A](F(math.NaN())).Add(unit1[A]().Scale(w))
+		default:
+			return NaN[A]()
+		}
+	case IsInf(uv):
+		switch {
+		case math.IsNaN(float64(w)):
+			return x
+		case math.IsInf(float64(w), 0):
+			return Lift[A](F(math.Copysign(math.Pi/4, float64(w)))).Add(uv)
+		default:
+			return Lift[A](F(math.Copysign(0, float64(w)))).Add(uv)
+		}
+	case math.IsInf(float64(w), 0):
+		return Lift[A](F(math.Copysign(math.Pi/2, float64(w)))).Add(signInf[A](uv))
+	}
+	w, _, n, isReal := split[A](x)
+	if isReal {
+		aw := math.Abs(float64(w))
+		if aw <= 1 {
+			return Lift[A](F(math.Asin(float64(w))))
+		}
+		n = unit1[A]()
+		var zero A
+		if n == zero {
+			return Lift[A](F(math.Asin(float64(w))))
+		}
+		re := F(math.Copysign(math.Pi/2, float64(w)))
+		im := F(math.Log(aw + math.Sqrt(aw*aw-1)))
+		return Lift[A](re).Add(n.Scale(im))
+	}
+	one := Lift[A](1)
+	return n.Neg().Mul(Log(n.Mul(x).Add(Sqrt(one.Add(x.Mul(x).Neg())))))
+}
+
+// Acos returns the inverse cosine of x.
+func Acos[A Value[A, F], F Field](x A) A {
+	return Lift[A](F(math.Pi / 2)).Add(Asin(x).Neg())
+}
+
+// Atan returns the inverse tangent of x.
+//
+// Atan conforms to the C99 Annex G.6 special cases adopted by
+// math/cmplx: Atan(NaN) is NaN, and Atan(w+v·n̂) for any infinite w or
+// v is π/2·sign(w), following from the identity's poles at ±i∞. For
+// purely-imaginary x with |v| ≤ 1, Atan uses atanh(v)·n̂ directly,
+// avoiding the 0/0 indeterminacy the general formula hits at the
+// branch points v = ±1.
+func Atan[A Value[A, F], F Field](x A) A {
+	w := x.Real()
+	uv := x.Imag()
+	switch {
+	case math.IsNaN(float64(w)) || IsNaN(uv):
+		return NaN[A]()
+	case math.IsInf(float64(w), 0) || IsInf(uv):
+		return Lift[A](F(math.Copysign(math.Pi/2, float64(w))))
+	}
+	w, v, n, isReal := split[A](x)
+	if isReal {
+		return Lift[A](F(math.Atan(float64(w))))
+	}
+	if w == 0 && v <= 1 {
+		return scaleInfDir[A](n, F(math.Atanh(float64(v))))
+	}
+	one := Lift[A](1)
+	iz := n.Mul(x)
+	num := one.Add(iz)
+	den := one.Add(iz.Neg())
+	return n.Scale(-0.5).Mul(Log(num.Mul(Inv(den))))
+}
@@ -0,0 +1,163 @@
+// Copyright ©2023 Dan Kortschak. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cd_test
+
+import (
+	"math"
+	"math/cmplx"
+	"testing"
+
+	"github.com/kortschak/cd"
+)
+
+// trigCases covers finite, real, purely-imaginary, infinite and NaN
+// inputs, matching the style of vc in math/cmplx/cmath_test.go.
+var trigCases = []complex128{
+	complex(0, 0),
+	complex(1, 2),
+	complex(-1, 2),
+	complex(0.5, -0.5),
+	complex(1, 0),
+	complex(1.5, 0),
+	complex(-1.5, 0),
+	complex(0, 1),
+	complex(math.Inf(1), 1),
+	complex(1, math.Inf(1)),
+	complex(1, math.Inf(-1)),
+	complex(0, math.Inf(1)),
+	complex(math.Inf(1), math.Inf(1)),
+	complex(math.NaN(), 1),
+	complex(1, math.NaN()),
+	complex(math.NaN(), math.NaN()),
+}
+
+// closeComplex reports whether got and want agree to within tol,
+// treating matching NaN and infinite elements as equal. closeFloat is
+// defined in nan_test.go.
+func closeComplex(got, want complex128, tol float64) bool {
+	return closeFloat(real(got), real(want), tol) && closeFloat(imag(got), imag(want), tol)
+}
+
+// TestTrigComplex checks the C instantiation of the package's
+// trigonometric and hyperbolic functions against math/cmplx.
+func TestTrigComplex(t *testing.T) {
+	cases := []struct {
+		name string
+		got  func(cd.C) cd.C
+		want func(complex128) complex128
+	}{
+		{"Sin", cd.Sin[cd.C], cmplx.Sin},
+		{"Cos", cd.Cos[cd.C], cmplx.Cos},
+		{"Sinh", cd.Sinh[cd.C], cmplx.Sinh},
+		{"Cosh", cd.Cosh[cd.C], cmplx.Cosh},
+		{"Asin", cd.Asin[cd.C], cmplx.Asin},
+		{"Atan", cd.Atan[cd.C], cmplx.Atan},
+	}
+	for _, c := range cases {
+		for _, v := range trigCases {
+			x := cd.NewC(real(v), imag(v))
+			e := c.got(x).Elems()
+			got := complex(e[0], e[1])
+			want := c.want(v)
+			if !closeComplex(got, want, 1e-9) {
+				t.Errorf("unexpected result for %s(%v): got:%v want:%v", c.name, v, got, want)
+			}
+		}
+	}
+}
+
+// TestTanComplex checks Tan and Tanh, which have no direct math/cmplx
+// special-case table to compare against Tan·Cos = Sin identically, so
+// are instead checked against the cmplx result away from the poles.
+func TestTanComplex(t *testing.T) {
+	cases := []complex128{
+		complex(1, 2), complex(-1, 2), complex(0.5, -0.5), complex(0, 1),
+	}
+	for _, v := range cases {
+		x := cd.NewC(real(v), imag(v))
+
+		e := cd.Tan(x).Elems()
+		got := complex(e[0], e[1])
+		want := cmplx.Tan(v)
+		if !closeComplex(got, want, 1e-9) {
+			t.Errorf("unexpected result for Tan(%v): got:%v want:%v", v, got, want)
+		}
+
+		e = cd.Tanh(x).Elems()
+		got = complex(e[0], e[1])
+		want = cmplx.Tanh(v)
+		if !closeComplex(got, want, 1e-9) {
+			t.Errorf("unexpected result for Tanh(%v): got:%v want:%v", v, got, want)
+		}
+	}
+}
+
+// TestTrigIdentitiesH extends the C-only comparison against math/cmplx
+// to H, where no reference implementation exists, by checking the
+// algebraic identities sin²+cos² = 1 and cosh²-sinh² = 1. These hold
+// for any Construction because Sin, Cos, Sinh and Cosh of a common x
+// are all linear combinations of 1 and the same unit direction n̂, a
+// commutative and associative subalgebra isomorphic to C.
+func TestTrigIdentitiesH(t *testing.T) {
+	cases := []cd.H{
+		cd.NewH(1, 2, 3, 4),
+		cd.NewH(0.3, -0.7, 1.1, 0),
+		cd.NewH(0, 1, 0, 0),
+		cd.NewH(2, 0, 0, 0),
+		cd.NewH(-2, 0, 0, 0),
+	}
+	const tol = 1e-9
+	for _, x := range cases {
+		s, c := cd.Sin(x), cd.Cos(x)
+		sum := s.Mul(s).Add(c.Mul(c))
+		if !allClose(sum.Elems(), 1, tol) {
+			t.Errorf("unexpected sin²+cos² for x=%+v: got:%+v want:1", x, sum)
+		}
+
+		sh, ch := cd.Sinh(x), cd.Cosh(x)
+		diff := ch.Mul(ch).Add(sh.Mul(sh).Neg())
+		if !allClose(diff.Elems(), 1, tol) {
+			t.Errorf("unexpected cosh²-sinh² for x=%+v: got:%+v want:1", x, diff)
+		}
+	}
+}
+
+// TestTrigIdentitiesO is TestTrigIdentitiesH extended to O.
+func TestTrigIdentitiesO(t *testing.T) {
+	cases := []cd.O{
+		cd.NewO(1, 1, 0.5, 0.5, 0, 0, 1, 0),
+		cd.NewO(0.3, -0.7, 1.1, 0, 0, 0, 0.2, 0),
+		cd.NewO(0, 0, 0, 0, 0, 0, 1, 0),
+		cd.NewO(2, 0, 0, 0, 0, 0, 0, 0),
+	}
+	const tol = 1e-9
+	for _, x := range cases {
+		s, c := cd.Sin(x), cd.Cos(x)
+		sum := s.Mul(s).Add(c.Mul(c))
+		if !allClose(sum.Elems(), 1, tol) {
+			t.Errorf("unexpected sin²+cos² for x=%+v: got:%+v want:1", x, sum)
+		}
+
+		sh, ch := cd.Sinh(x), cd.Cosh(x)
+		diff := ch.Mul(ch).Add(sh.Mul(sh).Neg())
+		if !allClose(diff.Elems(), 1, tol) {
+			t.Errorf("unexpected cosh²-sinh² for x=%+v: got:%+v want:1", x, diff)
+		}
+	}
+}
+
+// allClose reports whether elems is within tol of the real value want
+// in its leading element and within tol of 0 in every other element.
+func allClose(elems []float64, want, tol float64) bool {
+	if math.Abs(elems[0]-want) > tol {
+		return false
+	}
+	for _, e := range elems[1:] {
+		if math.Abs(e) > tol {
+			return false
+		}
+	}
+	return true
+}